@@ -0,0 +1,75 @@
+// Command weatherclient is a small smoke-test client for the weather gRPC
+// server: it asks for current conditions in a named city, a two-day
+// forecast, and then prints a handful of messages off the random-capital
+// stream.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/Eli-Goldberg/random-city-weather/internal/weatherpb"
+)
+
+func main() {
+	addr := flag.String("addr", "localhost:50051", "address of the weather gRPC server")
+	city := flag.String("city", "Paris", "city name to look up")
+	zip := flag.String("zip", "", "postal code to look up instead of --city")
+	zipCountry := flag.String("zip-country", "", "ISO 3166-1 alpha-2 country code disambiguating --zip")
+	flag.Parse()
+
+	conn, err := grpc.Dial(*addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		log.Fatalf("dialing %s: %v", *addr, err)
+	}
+	defer conn.Close()
+
+	client := weatherpb.NewWeatherServiceClient(conn)
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	loc := &weatherpb.Location{Value: &weatherpb.Location_CityName{CityName: *city}}
+	label := *city
+	if *zip != "" {
+		loc = &weatherpb.Location{Value: &weatherpb.Location_PostalCode{
+			PostalCode: &weatherpb.PostalCode{Zip: *zip, CountryCode: *zipCountry},
+		}}
+		label = *zip
+	}
+
+	w, err := client.Current(ctx, loc)
+	if err != nil {
+		log.Fatalf("Current(%s): %v", label, err)
+	}
+	fmt.Printf("current temperature in %s: %.1f°C\n", label, w.GetTemperatureC())
+
+	fc, err := client.Forecast(ctx, &weatherpb.ForecastRequest{Location: loc, Days: 2})
+	if err != nil {
+		log.Fatalf("Forecast(%s): %v", label, err)
+	}
+	for _, d := range fc.GetDays() {
+		fmt.Printf("forecast %s: max %.1f°C\n", d.GetDate(), d.GetTempMaxC())
+	}
+
+	stream, err := client.RandomCapitalStream(ctx, &weatherpb.Interval{Seconds: 5})
+	if err != nil {
+		log.Fatalf("RandomCapitalStream: %v", err)
+	}
+	for i := 0; i < 3; i++ {
+		msg, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			log.Fatalf("stream.Recv: %v", err)
+		}
+		fmt.Printf("%s, %s: %.1f°C\n", msg.GetCity(), msg.GetCountry(), msg.GetWeather().GetTemperatureC())
+	}
+}
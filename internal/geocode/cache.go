@@ -0,0 +1,133 @@
+package geocode
+
+import (
+	"container/list"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/Eli-Goldberg/random-city-weather/internal/weather"
+)
+
+// diskCacheFile is where a cache persists between runs: $XDG_CACHE_HOME, or
+// ~/.cache if unset, following the XDG Base Directory spec.
+const diskCacheFile = "random-city-weather/geocode-cache.json"
+
+// lruCache is a bounded, disk-backed LRU cache of geocoding results. It is
+// safe for concurrent use.
+type lruCache struct {
+	mu       sync.Mutex
+	capacity int
+	path     string
+	ll       *list.List
+	items    map[string]*list.Element
+}
+
+type cacheEntry struct {
+	Key         string              `json:"key"`
+	Coordinates weather.Coordinates `json:"coordinates"`
+}
+
+// newLRUCache builds a cache bounded to capacity entries, loading any
+// previously persisted entries from disk.
+func newLRUCache(capacity int) *lruCache {
+	c := &lruCache{
+		capacity: capacity,
+		path:     cachePath(),
+		ll:       list.New(),
+		items:    make(map[string]*list.Element),
+	}
+	c.load()
+	return c
+}
+
+func cachePath() string {
+	base := os.Getenv("XDG_CACHE_HOME")
+	if base == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return ""
+		}
+		base = filepath.Join(home, ".cache")
+	}
+	return filepath.Join(base, diskCacheFile)
+}
+
+func (c *lruCache) get(key string) (weather.Coordinates, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	el, ok := c.items[key]
+	if !ok {
+		return weather.Coordinates{}, false
+	}
+	c.ll.MoveToFront(el)
+	return el.Value.(*cacheEntry).Coordinates, true
+}
+
+func (c *lruCache) put(key string, coords weather.Coordinates) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if el, ok := c.items[key]; ok {
+		el.Value.(*cacheEntry).Coordinates = coords
+		c.ll.MoveToFront(el)
+		return
+	}
+
+	el := c.ll.PushFront(&cacheEntry{Key: key, Coordinates: coords})
+	c.items[key] = el
+
+	for c.ll.Len() > c.capacity {
+		oldest := c.ll.Back()
+		if oldest == nil {
+			break
+		}
+		c.ll.Remove(oldest)
+		delete(c.items, oldest.Value.(*cacheEntry).Key)
+	}
+
+	c.saveLocked()
+}
+
+func (c *lruCache) load() {
+	if c.path == "" {
+		return
+	}
+	data, err := os.ReadFile(c.path)
+	if err != nil {
+		return
+	}
+
+	var entries []cacheEntry
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return
+	}
+
+	for _, e := range entries {
+		el := c.ll.PushFront(&cacheEntry{Key: e.Key, Coordinates: e.Coordinates})
+		c.items[e.Key] = el
+	}
+}
+
+// saveLocked persists the cache to disk. Callers must hold c.mu.
+func (c *lruCache) saveLocked() {
+	if c.path == "" {
+		return
+	}
+	if err := os.MkdirAll(filepath.Dir(c.path), 0o755); err != nil {
+		return
+	}
+
+	entries := make([]cacheEntry, 0, c.ll.Len())
+	for el := c.ll.Front(); el != nil; el = el.Next() {
+		entries = append(entries, *el.Value.(*cacheEntry))
+	}
+
+	data, err := json.Marshal(entries)
+	if err != nil {
+		return
+	}
+	_ = os.WriteFile(c.path, data, 0o644)
+}
@@ -0,0 +1,64 @@
+package geocode
+
+import (
+	"testing"
+
+	"github.com/Eli-Goldberg/random-city-weather/internal/weather"
+)
+
+func TestLRUCacheEvictsOldestOnceOverCapacity(t *testing.T) {
+	t.Setenv("XDG_CACHE_HOME", t.TempDir())
+
+	c := newLRUCache(2)
+	c.put("a", weather.Coordinates{Latitude: 1})
+	c.put("b", weather.Coordinates{Latitude: 2})
+	c.put("c", weather.Coordinates{Latitude: 3})
+
+	if _, ok := c.get("a"); ok {
+		t.Error("expected \"a\" to be evicted once capacity was exceeded")
+	}
+	if _, ok := c.get("b"); !ok {
+		t.Error("expected \"b\" to still be cached")
+	}
+	if _, ok := c.get("c"); !ok {
+		t.Error("expected \"c\" to still be cached")
+	}
+}
+
+func TestLRUCacheGetRefreshesRecency(t *testing.T) {
+	t.Setenv("XDG_CACHE_HOME", t.TempDir())
+
+	c := newLRUCache(2)
+	c.put("a", weather.Coordinates{Latitude: 1})
+	c.put("b", weather.Coordinates{Latitude: 2})
+
+	// Touch "a" so it's no longer the least-recently-used entry.
+	if _, ok := c.get("a"); !ok {
+		t.Fatal("expected \"a\" to be cached")
+	}
+	c.put("c", weather.Coordinates{Latitude: 3})
+
+	if _, ok := c.get("a"); !ok {
+		t.Error("expected \"a\" to survive eviction after being refreshed")
+	}
+	if _, ok := c.get("b"); ok {
+		t.Error("expected \"b\" to be evicted as the least-recently-used entry")
+	}
+}
+
+func TestLRUCachePersistsAcrossInstances(t *testing.T) {
+	t.Setenv("XDG_CACHE_HOME", t.TempDir())
+
+	want := weather.Coordinates{Latitude: 48.8566, Longitude: 2.3522}
+	c := newLRUCache(defaultCacheCapacity)
+	c.put("city:fr:paris", want)
+
+	reloaded := newLRUCache(defaultCacheCapacity)
+	got, ok := reloaded.get("city:fr:paris")
+	if !ok {
+		t.Fatal("expected entry to survive reload from disk")
+	}
+	if got != want {
+		t.Errorf("got %+v, want %+v", got, want)
+	}
+}
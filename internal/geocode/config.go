@@ -0,0 +1,21 @@
+package geocode
+
+import "fmt"
+
+// Names of the supported geocoders, as accepted by --geocoder / GEOCODE_PROVIDER.
+const (
+	NominatimName = "nominatim"
+	OpenMeteoName = "open-meteo"
+)
+
+// New builds the Geocoder selected by name.
+func New(name string) (Geocoder, error) {
+	switch name {
+	case "", NominatimName:
+		return NewNominatim(), nil
+	case OpenMeteoName:
+		return NewOpenMeteoGeocoder(), nil
+	default:
+		return nil, fmt.Errorf("unknown geocoder %q", name)
+	}
+}
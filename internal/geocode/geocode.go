@@ -0,0 +1,21 @@
+// Package geocode resolves city names and postal codes to coordinates. It
+// has no opinion on which upstream API does the resolving — callers pick an
+// implementation of Geocoder.
+package geocode
+
+import (
+	"context"
+
+	"github.com/Eli-Goldberg/random-city-weather/internal/weather"
+)
+
+// Geocoder resolves place descriptions to coordinates.
+type Geocoder interface {
+	// ByCity resolves a city name, optionally disambiguated by an ISO
+	// 3166-1 alpha-2 country code (empty string if unknown).
+	ByCity(ctx context.Context, name, countryCode string) (weather.Coordinates, error)
+	// ByPostalCode resolves a postal/ZIP code within countryCode.
+	ByPostalCode(ctx context.Context, zip, countryCode string) (weather.Coordinates, error)
+	// Name identifies which backend this Geocoder uses, for metric labeling.
+	Name() string
+}
@@ -0,0 +1,149 @@
+package geocode
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"golang.org/x/time/rate"
+
+	"github.com/Eli-Goldberg/random-city-weather/internal/weather"
+)
+
+// Nominatim requires a descriptive User-Agent per the OSM usage policy:
+// https://operations.osmfoundation.org/policies/nominatim/
+const nominatimUserAgent = "random-city-weather/1.0 github.com/Eli-Goldberg/random-city-weather"
+
+const nominatimBaseURL = "https://nominatim.openstreetmap.org/search"
+
+const defaultCacheCapacity = 512
+
+// Nominatim is a Geocoder backed by the OpenStreetMap Nominatim API. It
+// enforces Nominatim's 1 request/second usage policy with an internal
+// token-bucket limiter, retries transient failures with exponential
+// backoff, and caches resolved queries on disk so repeated lookups (e.g. the
+// same capital coming up again) don't re-hit the API.
+type Nominatim struct {
+	httpClient *http.Client
+	limiter    *rate.Limiter
+	cache      *lruCache
+}
+
+// NewNominatim builds a Nominatim geocoder.
+func NewNominatim() *Nominatim {
+	return &Nominatim{
+		httpClient: &http.Client{Timeout: 10 * time.Second},
+		limiter:    rate.NewLimiter(rate.Limit(1), 1),
+		cache:      newLRUCache(defaultCacheCapacity),
+	}
+}
+
+func (n *Nominatim) Name() string { return NominatimName }
+
+func (n *Nominatim) ByCity(ctx context.Context, name, countryCode string) (weather.Coordinates, error) {
+	params := url.Values{}
+	params.Set("q", name)
+	if countryCode != "" {
+		params.Set("countrycodes", strings.ToLower(countryCode))
+	}
+	return n.query(ctx, "city:"+strings.ToLower(countryCode)+":"+strings.ToLower(name), params)
+}
+
+func (n *Nominatim) ByPostalCode(ctx context.Context, zip, countryCode string) (weather.Coordinates, error) {
+	params := url.Values{}
+	params.Set("postalcode", zip)
+	if countryCode != "" {
+		params.Set("countrycodes", strings.ToLower(countryCode))
+	}
+	return n.query(ctx, "zip:"+strings.ToLower(countryCode)+":"+zip, params)
+}
+
+func (n *Nominatim) query(ctx context.Context, cacheKey string, params url.Values) (weather.Coordinates, error) {
+	if coords, ok := n.cache.get(cacheKey); ok {
+		return coords, nil
+	}
+
+	params.Set("format", "json")
+	endpointURL := fmt.Sprintf("%s?%s", nominatimBaseURL, params.Encode())
+
+	coords, err := n.fetchWithRetry(ctx, endpointURL)
+	if err != nil {
+		return weather.Coordinates{}, err
+	}
+
+	n.cache.put(cacheKey, coords)
+	return coords, nil
+}
+
+func (n *Nominatim) fetchWithRetry(ctx context.Context, endpointURL string) (weather.Coordinates, error) {
+	const maxAttempts = 4
+	backoff := 500 * time.Millisecond
+
+	var lastErr error
+	for attempt := 0; attempt < maxAttempts; attempt++ {
+		if err := n.limiter.Wait(ctx); err != nil {
+			return weather.Coordinates{}, err
+		}
+
+		coords, retryable, err := n.fetchOnce(ctx, endpointURL)
+		if err == nil {
+			return coords, nil
+		}
+		lastErr = err
+		if !retryable {
+			return weather.Coordinates{}, err
+		}
+
+		select {
+		case <-ctx.Done():
+			return weather.Coordinates{}, ctx.Err()
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+	}
+
+	return weather.Coordinates{}, fmt.Errorf("nominatim: giving up after %d attempts: %w", maxAttempts, lastErr)
+}
+
+// fetchOnce performs a single request, reporting whether a failure is worth
+// retrying (rate limited or server error).
+func (n *Nominatim) fetchOnce(ctx context.Context, endpointURL string) (coords weather.Coordinates, retryable bool, err error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpointURL, nil)
+	if err != nil {
+		return weather.Coordinates{}, false, err
+	}
+	req.Header.Set("User-Agent", nominatimUserAgent)
+
+	resp, err := n.httpClient.Do(req)
+	if err != nil {
+		return weather.Coordinates{}, true, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500 {
+		return weather.Coordinates{}, true, fmt.Errorf("nominatim: status %d", resp.StatusCode)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return weather.Coordinates{}, false, fmt.Errorf("nominatim: status %d", resp.StatusCode)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return weather.Coordinates{}, true, err
+	}
+
+	var results []weather.Coordinates
+	if err := json.Unmarshal(body, &results); err != nil {
+		return weather.Coordinates{}, false, err
+	}
+	if len(results) == 0 {
+		return weather.Coordinates{}, false, fmt.Errorf("no coordinates found")
+	}
+
+	return results[0], false, nil
+}
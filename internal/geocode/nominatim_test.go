@@ -0,0 +1,45 @@
+package geocode
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestNominatimFetchOnceRetryClassification(t *testing.T) {
+	tests := []struct {
+		name          string
+		status        int
+		body          string
+		wantRetryable bool
+		wantErr       bool
+	}{
+		{name: "ok", status: http.StatusOK, body: `[{"lat":"1","lon":"2"}]`, wantErr: false},
+		{name: "rate limited", status: http.StatusTooManyRequests, wantRetryable: true, wantErr: true},
+		{name: "server error", status: http.StatusInternalServerError, wantRetryable: true, wantErr: true},
+		{name: "not found", status: http.StatusNotFound, wantRetryable: false, wantErr: true},
+		{name: "no results", status: http.StatusOK, body: `[]`, wantRetryable: false, wantErr: true},
+		{name: "bad json", status: http.StatusOK, body: `not json`, wantRetryable: false, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.status)
+				w.Write([]byte(tt.body))
+			}))
+			defer srv.Close()
+
+			n := NewNominatim()
+			_, retryable, err := n.fetchOnce(context.Background(), srv.URL)
+
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("err = %v, wantErr = %v", err, tt.wantErr)
+			}
+			if retryable != tt.wantRetryable {
+				t.Errorf("retryable = %v, want %v", retryable, tt.wantRetryable)
+			}
+		})
+	}
+}
@@ -0,0 +1,77 @@
+package geocode
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/Eli-Goldberg/random-city-weather/internal/weather"
+)
+
+const openMeteoGeocodeURL = "https://geocoding-api.open-meteo.com/v1/search"
+
+// OpenMeteoGeocoder is a Geocoder backed by Open-Meteo's own geocoding API,
+// used as a fallback when Nominatim is unavailable or rate-limited.
+type OpenMeteoGeocoder struct {
+	httpClient *http.Client
+}
+
+// NewOpenMeteoGeocoder builds an Open-Meteo-backed geocoder.
+func NewOpenMeteoGeocoder() *OpenMeteoGeocoder {
+	return &OpenMeteoGeocoder{httpClient: &http.Client{Timeout: 10 * time.Second}}
+}
+
+func (g *OpenMeteoGeocoder) Name() string { return OpenMeteoName }
+
+func (g *OpenMeteoGeocoder) ByCity(ctx context.Context, name, countryCode string) (weather.Coordinates, error) {
+	params := url.Values{}
+	params.Set("name", name)
+	params.Set("count", "1")
+	if countryCode != "" {
+		params.Set("country_code", strings.ToUpper(countryCode))
+	}
+	endpointURL := fmt.Sprintf("%s?%s", openMeteoGeocodeURL, params.Encode())
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpointURL, nil)
+	if err != nil {
+		return weather.Coordinates{}, err
+	}
+
+	resp, err := g.httpClient.Do(req)
+	if err != nil {
+		return weather.Coordinates{}, fmt.Errorf("open-meteo geocode: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return weather.Coordinates{}, fmt.Errorf("open-meteo geocode: status %d", resp.StatusCode)
+	}
+
+	var parsed struct {
+		Results []struct {
+			Latitude  float64 `json:"latitude"`
+			Longitude float64 `json:"longitude"`
+		} `json:"results"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&parsed); err != nil {
+		return weather.Coordinates{}, fmt.Errorf("open-meteo geocode: decoding response: %w", err)
+	}
+	if len(parsed.Results) == 0 {
+		return weather.Coordinates{}, fmt.Errorf("open-meteo geocode: no coordinates found for %s", name)
+	}
+
+	return weather.Coordinates{
+		Latitude:  parsed.Results[0].Latitude,
+		Longitude: parsed.Results[0].Longitude,
+	}, nil
+}
+
+// ByPostalCode is unsupported: Open-Meteo's geocoding API has no postal-code
+// search, so this fallback only covers city lookups.
+func (g *OpenMeteoGeocoder) ByPostalCode(ctx context.Context, zip, countryCode string) (weather.Coordinates, error) {
+	return weather.Coordinates{}, fmt.Errorf("open-meteo geocode: postal code lookup not supported")
+}
@@ -0,0 +1,54 @@
+// Package metrics holds the Prometheus instrumentation for the weather
+// fetch loop, following the same per-city structured-observation shape as
+// Telegraf's openweathermap input plugin.
+package metrics
+
+import (
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// Metrics bundles the counters, histograms, and gauges exported on /metrics.
+type Metrics struct {
+	RequestsTotal     *prometheus.CounterVec
+	GeocodeLatency    *prometheus.HistogramVec
+	WeatherLatency    *prometheus.HistogramVec
+	TemperatureByCity *prometheus.GaugeVec
+}
+
+// New registers and returns the fetch-loop metrics against reg.
+func New(reg prometheus.Registerer) *Metrics {
+	factory := promauto.With(reg)
+
+	return &Metrics{
+		RequestsTotal: factory.NewCounterVec(prometheus.CounterOpts{
+			Name: "weather_requests_total",
+			Help: "Total upstream requests, labelled by provider and outcome.",
+		}, []string{"provider", "outcome"}),
+
+		GeocodeLatency: factory.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "weather_geocode_latency_seconds",
+			Help:    "Latency of city/ZIP-to-coordinates lookups.",
+			Buckets: prometheus.DefBuckets,
+		}, []string{"geocoder"}),
+
+		WeatherLatency: factory.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "weather_fetch_latency_seconds",
+			Help:    "Latency of current-conditions fetches.",
+			Buckets: prometheus.DefBuckets,
+		}, []string{"provider"}),
+
+		TemperatureByCity: factory.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "weather_temperature_celsius",
+			Help: "Most recently observed temperature, labelled by city and country.",
+		}, []string{"city", "country"}),
+	}
+}
+
+// Handler returns the HTTP handler to mount at /metrics.
+func Handler() http.Handler {
+	return promhttp.Handler()
+}
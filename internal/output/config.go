@@ -0,0 +1,60 @@
+package output
+
+import (
+	"fmt"
+	"os"
+)
+
+// Names of the supported output modes, as accepted by --output.
+const (
+	HumanName  = "human"
+	NDJSONName = "ndjson"
+	CSVName    = "csv"
+	SQLiteName = "sqlite"
+)
+
+// defaultMaxBytes is the rotation threshold used when a file-backed sink is
+// given a path: 100MiB keeps individual files manageable for long-running
+// collection without needing a separate flag for the common case.
+const defaultMaxBytes = 100 * 1024 * 1024
+
+// New builds the Sink selected by name. path is required for csv and
+// sqlite; for human and ndjson an empty path means "write to stdout".
+func New(name, path string) (Sink, error) {
+	switch name {
+	case "", HumanName:
+		if path == "" {
+			return NewHuman(os.Stdout), nil
+		}
+		rf, err := NewRotatingFile(path, defaultMaxBytes)
+		if err != nil {
+			return nil, err
+		}
+		return NewHuman(rf), nil
+
+	case NDJSONName:
+		if path == "" {
+			return NewNDJSON(os.Stdout), nil
+		}
+		rf, err := NewRotatingFile(path, defaultMaxBytes)
+		if err != nil {
+			return nil, err
+		}
+		return NewNDJSON(rf), nil
+
+	case CSVName:
+		if path == "" {
+			return nil, fmt.Errorf("output: --output-path is required for csv")
+		}
+		return NewCSV(path)
+
+	case SQLiteName:
+		if path == "" {
+			return nil, fmt.Errorf("output: --output-path is required for sqlite")
+		}
+		return NewSQLite(path)
+
+	default:
+		return nil, fmt.Errorf("unknown output mode %q", name)
+	}
+}
@@ -0,0 +1,78 @@
+package output
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"os"
+	"sync"
+)
+
+var csvHeader = []string{
+	"timestamp", "country", "city", "lat", "lon",
+	"temp_c", "humidity", "cloud_cover", "wind_speed", "provider",
+}
+
+// csvSink appends one row per observation to a CSV file, writing the header
+// once when the file is first created.
+type csvSink struct {
+	mu sync.Mutex
+	f  *os.File
+	w  *csv.Writer
+}
+
+// NewCSV opens (or creates) path and builds a CSV Sink over it.
+func NewCSV(path string) (Sink, error) {
+	existed := fileExists(path)
+
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return nil, fmt.Errorf("output: opening %s: %w", path, err)
+	}
+
+	w := csv.NewWriter(f)
+	if !existed {
+		if err := w.Write(csvHeader); err != nil {
+			f.Close()
+			return nil, fmt.Errorf("output: writing CSV header: %w", err)
+		}
+		w.Flush()
+	}
+
+	return &csvSink{f: f, w: w}, nil
+}
+
+func fileExists(path string) bool {
+	info, err := os.Stat(path)
+	return err == nil && !info.IsDir() && info.Size() > 0
+}
+
+func (s *csvSink) Write(_ context.Context, obs Observation) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	row := []string{
+		obs.Timestamp.UTC().Format("2006-01-02T15:04:05Z07:00"),
+		obs.Country,
+		obs.City,
+		fmt.Sprintf("%g", obs.Latitude),
+		fmt.Sprintf("%g", obs.Longitude),
+		fmt.Sprintf("%g", obs.TemperatureC),
+		fmt.Sprintf("%g", obs.HumidityPercent),
+		fmt.Sprintf("%g", obs.CloudCoverPercent),
+		fmt.Sprintf("%g", obs.WindSpeed),
+		obs.Provider,
+	}
+	if err := s.w.Write(row); err != nil {
+		return err
+	}
+	s.w.Flush()
+	return s.w.Error()
+}
+
+func (s *csvSink) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.w.Flush()
+	return s.f.Close()
+}
@@ -0,0 +1,34 @@
+package output
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sync"
+)
+
+// humanSink writes one human-readable line per observation, reproducing the
+// original CLI's output.
+type humanSink struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+// NewHuman builds a Sink that prints a human-readable line per observation.
+func NewHuman(w io.Writer) Sink {
+	return &humanSink{w: w}
+}
+
+func (s *humanSink) Write(_ context.Context, obs Observation) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, err := fmt.Fprintf(s.w, "the Temperature in %s, %s is: %.1f°C\n", obs.City, obs.Country, obs.TemperatureC)
+	return err
+}
+
+func (s *humanSink) Close() error {
+	if c, ok := s.w.(io.Closer); ok {
+		return c.Close()
+	}
+	return nil
+}
@@ -0,0 +1,34 @@
+package output
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"sync"
+)
+
+// ndjsonSink writes one JSON object per line, suitable for piping into
+// jq/log collectors.
+type ndjsonSink struct {
+	mu  sync.Mutex
+	w   io.Writer
+	enc *json.Encoder
+}
+
+// NewNDJSON builds a Sink that writes newline-delimited JSON to w.
+func NewNDJSON(w io.Writer) Sink {
+	return &ndjsonSink{w: w, enc: json.NewEncoder(w)}
+}
+
+func (s *ndjsonSink) Write(_ context.Context, obs Observation) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.enc.Encode(obs)
+}
+
+func (s *ndjsonSink) Close() error {
+	if c, ok := s.w.(io.Closer); ok {
+		return c.Close()
+	}
+	return nil
+}
@@ -0,0 +1,33 @@
+// Package output persists fetched weather observations in whatever shape
+// downstream tooling wants — a human-readable line, a stream of NDJSON
+// records, a CSV file, or a SQLite database — behind a single Sink
+// interface.
+package output
+
+import (
+	"context"
+	"time"
+)
+
+// Observation is one fully-resolved weather reading, carrying enough detail
+// for downstream tooling to consume as a stream of events rather than just
+// a printed temperature.
+type Observation struct {
+	Timestamp         time.Time `json:"timestamp"`
+	Country           string    `json:"country"`
+	City              string    `json:"city"`
+	Latitude          float64   `json:"lat"`
+	Longitude         float64   `json:"lon"`
+	TemperatureC      float64   `json:"temp_c"`
+	HumidityPercent   float64   `json:"humidity"`
+	CloudCoverPercent float64   `json:"cloud_cover"`
+	WindSpeed         float64   `json:"wind_speed"`
+	Provider          string    `json:"provider"`
+}
+
+// Sink persists Observations. Implementations must be safe for concurrent
+// use by multiple callers.
+type Sink interface {
+	Write(ctx context.Context, obs Observation) error
+	Close() error
+}
@@ -0,0 +1,78 @@
+package output
+
+import (
+	"fmt"
+	"os"
+	"sync"
+	"time"
+)
+
+// RotatingFile is an io.WriteCloser over a file that renames itself aside
+// and reopens once it grows past maxBytes, so a long-running collection run
+// doesn't produce one unbounded file.
+type RotatingFile struct {
+	mu       sync.Mutex
+	path     string
+	maxBytes int64
+
+	f    *os.File
+	size int64
+}
+
+// NewRotatingFile opens (or creates) path for appending.
+func NewRotatingFile(path string, maxBytes int64) (*RotatingFile, error) {
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return nil, fmt.Errorf("output: opening %s: %w", path, err)
+	}
+	info, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return nil, fmt.Errorf("output: statting %s: %w", path, err)
+	}
+
+	return &RotatingFile{path: path, maxBytes: maxBytes, f: f, size: info.Size()}, nil
+}
+
+func (r *RotatingFile) Write(p []byte) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.maxBytes > 0 && r.size+int64(len(p)) > r.maxBytes {
+		if err := r.rotateLocked(); err != nil {
+			return 0, err
+		}
+	}
+
+	n, err := r.f.Write(p)
+	r.size += int64(n)
+	return n, err
+}
+
+// rotateLocked closes the current file, renames it aside with a timestamp
+// suffix, and opens a fresh file at the original path. Callers must hold
+// r.mu.
+func (r *RotatingFile) rotateLocked() error {
+	if err := r.f.Close(); err != nil {
+		return fmt.Errorf("output: closing %s for rotation: %w", r.path, err)
+	}
+
+	rotated := fmt.Sprintf("%s.%s", r.path, time.Now().UTC().Format("20060102T150405Z"))
+	if err := os.Rename(r.path, rotated); err != nil {
+		return fmt.Errorf("output: rotating %s: %w", r.path, err)
+	}
+
+	f, err := os.OpenFile(r.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return fmt.Errorf("output: reopening %s after rotation: %w", r.path, err)
+	}
+	r.f = f
+	r.size = 0
+	return nil
+}
+
+func (r *RotatingFile) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.f.Close()
+}
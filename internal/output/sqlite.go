@@ -0,0 +1,68 @@
+package output
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	_ "modernc.org/sqlite"
+)
+
+const sqliteSchema = `
+CREATE TABLE IF NOT EXISTS observations (
+	timestamp   TEXT NOT NULL,
+	country     TEXT NOT NULL,
+	city        TEXT NOT NULL,
+	lat         REAL NOT NULL,
+	lon         REAL NOT NULL,
+	temp_c      REAL NOT NULL,
+	humidity    REAL NOT NULL,
+	cloud_cover REAL NOT NULL,
+	wind_speed  REAL NOT NULL,
+	provider    TEXT NOT NULL
+);`
+
+const sqliteInsert = `
+INSERT INTO observations (timestamp, country, city, lat, lon, temp_c, humidity, cloud_cover, wind_speed, provider)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?);`
+
+// sqliteSink persists observations to a local SQLite database.
+type sqliteSink struct {
+	db *sql.DB
+}
+
+// NewSQLite opens (or creates) the SQLite database at path and ensures the
+// observations table exists.
+func NewSQLite(path string) (Sink, error) {
+	db, err := sql.Open("sqlite", path)
+	if err != nil {
+		return nil, fmt.Errorf("output: opening sqlite database %s: %w", path, err)
+	}
+
+	if _, err := db.Exec(sqliteSchema); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("output: creating schema: %w", err)
+	}
+
+	return &sqliteSink{db: db}, nil
+}
+
+func (s *sqliteSink) Write(ctx context.Context, obs Observation) error {
+	_, err := s.db.ExecContext(ctx, sqliteInsert,
+		obs.Timestamp.UTC().Format("2006-01-02T15:04:05Z07:00"),
+		obs.Country,
+		obs.City,
+		obs.Latitude,
+		obs.Longitude,
+		obs.TemperatureC,
+		obs.HumidityPercent,
+		obs.CloudCoverPercent,
+		obs.WindSpeed,
+		obs.Provider,
+	)
+	return err
+}
+
+func (s *sqliteSink) Close() error {
+	return s.db.Close()
+}
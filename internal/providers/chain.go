@@ -0,0 +1,48 @@
+package providers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Eli-Goldberg/random-city-weather/internal/weather"
+)
+
+// Chain tries each provider in order, falling through to the next on error.
+// It reports itself under the name of whichever provider last answered
+// successfully isn't tracked per-call; callers that need attribution should
+// inspect the error chain or call providers individually.
+type Chain struct {
+	providers []WeatherProvider
+}
+
+// NewChain builds a fallback Chain. The first provider is tried first.
+func NewChain(providers ...WeatherProvider) *Chain {
+	return &Chain{providers: providers}
+}
+
+func (c *Chain) Name() string { return "chain" }
+
+func (c *Chain) Current(ctx context.Context, coord weather.Coordinates) (*weather.Weather, error) {
+	var errs []error
+	for _, p := range c.providers {
+		w, err := p.Current(ctx, coord)
+		if err == nil {
+			return w, nil
+		}
+		errs = append(errs, fmt.Errorf("%s: %w", p.Name(), err))
+	}
+	return nil, errors.Join(errs...)
+}
+
+func (c *Chain) Forecast(ctx context.Context, coord weather.Coordinates, opts ForecastOptions) (*weather.Forecast, error) {
+	var errs []error
+	for _, p := range c.providers {
+		fc, err := p.Forecast(ctx, coord, opts)
+		if err == nil {
+			return fc, nil
+		}
+		errs = append(errs, fmt.Errorf("%s: %w", p.Name(), err))
+	}
+	return nil, errors.Join(errs...)
+}
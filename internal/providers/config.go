@@ -0,0 +1,28 @@
+package providers
+
+import (
+	"fmt"
+
+	"github.com/hectormalot/omgo"
+)
+
+// Names of the supported providers, as accepted by --provider / WEATHER_PROVIDER.
+const (
+	OpenMeteoName = "open-meteo"
+	MetNoName     = "met-no"
+)
+
+// New builds the provider selected by name. "chain" builds a fallback chain
+// trying Open-Meteo first, then MET Norway.
+func New(name string, omgoClient omgo.Client) (WeatherProvider, error) {
+	switch name {
+	case "", OpenMeteoName:
+		return NewOpenMeteo(omgoClient), nil
+	case MetNoName:
+		return NewMetNo(), nil
+	case "chain":
+		return NewChain(NewOpenMeteo(omgoClient), NewMetNo()), nil
+	default:
+		return nil, fmt.Errorf("unknown weather provider %q", name)
+	}
+}
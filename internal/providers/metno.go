@@ -0,0 +1,201 @@
+package providers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/Eli-Goldberg/random-city-weather/internal/weather"
+)
+
+// MET Norway requires a descriptive User-Agent identifying the application
+// and, ideally, a contact point. See
+// https://api.met.no/doc/TermsOfService
+const metNoUserAgent = "random-city-weather/1.0 github.com/Eli-Goldberg/random-city-weather"
+
+const metNoBaseURL = "https://api.met.no/weatherapi/locationforecast/2.0/compact"
+
+// MetNo is a WeatherProvider backed by MET Norway's Locationforecast API.
+// It honors the API's conditional-request caching contract: each response
+// carries an Expires header, and we resend the last response's Last-Modified
+// as If-Modified-Since so unchanged forecasts come back as a cheap 304.
+type MetNo struct {
+	httpClient *http.Client
+
+	mu    sync.Mutex
+	cache map[string]*metNoCacheEntry
+}
+
+type metNoCacheEntry struct {
+	expires      time.Time
+	lastModified string
+	response     metNoResponse
+}
+
+// NewMetNo builds a MET Norway provider with an in-memory response cache.
+func NewMetNo() *MetNo {
+	return &MetNo{
+		httpClient: &http.Client{Timeout: 10 * time.Second},
+		cache:      make(map[string]*metNoCacheEntry),
+	}
+}
+
+func (p *MetNo) Name() string { return "met-no" }
+
+// cacheKey rounds coordinates to 4 decimal places (~11m) so nearby lookups
+// for the same city share a cache entry, per MET Norway's guidance to avoid
+// needlessly precise queries.
+func cacheKey(coord weather.Coordinates) string {
+	round := func(f float64) float64 { return math.Round(f*10000) / 10000 }
+	return fmt.Sprintf("%.4f,%.4f", round(coord.Latitude), round(coord.Longitude))
+}
+
+func (p *MetNo) fetch(ctx context.Context, coord weather.Coordinates) (metNoResponse, error) {
+	key := cacheKey(coord)
+
+	p.mu.Lock()
+	entry := p.cache[key]
+	p.mu.Unlock()
+
+	if entry != nil && time.Now().Before(entry.expires) {
+		return entry.response, nil
+	}
+
+	url := fmt.Sprintf("%s?lat=%.4f&lon=%.4f", metNoBaseURL, coord.Latitude, coord.Longitude)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return metNoResponse{}, err
+	}
+	req.Header.Set("User-Agent", metNoUserAgent)
+	if entry != nil && entry.lastModified != "" {
+		req.Header.Set("If-Modified-Since", entry.lastModified)
+	}
+
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return metNoResponse{}, fmt.Errorf("met-no: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotModified && entry != nil {
+		p.mu.Lock()
+		entry.expires = parseExpires(resp.Header.Get("Expires"))
+		p.mu.Unlock()
+		return entry.response, nil
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return metNoResponse{}, fmt.Errorf("met-no: unexpected status %d", resp.StatusCode)
+	}
+
+	var parsed metNoResponse
+	if err := json.NewDecoder(resp.Body).Decode(&parsed); err != nil {
+		return metNoResponse{}, fmt.Errorf("met-no: decoding response: %w", err)
+	}
+
+	newEntry := &metNoCacheEntry{
+		expires:      parseExpires(resp.Header.Get("Expires")),
+		lastModified: resp.Header.Get("Last-Modified"),
+		response:     parsed,
+	}
+	p.mu.Lock()
+	p.cache[key] = newEntry
+	p.mu.Unlock()
+
+	return parsed, nil
+}
+
+func parseExpires(h string) time.Time {
+	if h == "" {
+		return time.Now().Add(time.Minute)
+	}
+	t, err := time.Parse(time.RFC1123, h)
+	if err != nil {
+		return time.Now().Add(time.Minute)
+	}
+	return t
+}
+
+func (p *MetNo) Current(ctx context.Context, coord weather.Coordinates) (*weather.Weather, error) {
+	resp, err := p.fetch(ctx, coord)
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.Properties.Timeseries) == 0 {
+		return nil, fmt.Errorf("met-no: no timeseries data for %v", coord)
+	}
+
+	details := resp.Properties.Timeseries[0].Data.Instant.Details
+	return &weather.Weather{
+		Temperature: details.AirTemperature,
+		Humidity:    details.RelativeHumidity,
+		CloudCover:  details.CloudAreaFraction,
+		WindSpeed:   details.WindSpeed,
+	}, nil
+}
+
+func (p *MetNo) Forecast(ctx context.Context, coord weather.Coordinates, opts ForecastOptions) (*weather.Forecast, error) {
+	resp, err := p.fetch(ctx, coord)
+	if err != nil {
+		return nil, err
+	}
+
+	days := opts.Days
+	if days <= 0 {
+		days = 1
+	}
+
+	byDate := map[string]*weather.DayForecast{}
+	var order []string
+	for _, ts := range resp.Properties.Timeseries {
+		date := ts.Time[:10]
+		day, ok := byDate[date]
+		if !ok {
+			if len(order) >= days {
+				continue
+			}
+			day = &weather.DayForecast{Date: date, TempMax: -math.MaxFloat64, TempMin: math.MaxFloat64}
+			byDate[date] = day
+			order = append(order, date)
+		}
+		temp := ts.Data.Instant.Details.AirTemperature
+		if temp > day.TempMax {
+			day.TempMax = temp
+		}
+		if temp < day.TempMin {
+			day.TempMin = temp
+		}
+		day.Humidity = ts.Data.Instant.Details.RelativeHumidity
+		day.CloudCover = ts.Data.Instant.Details.CloudAreaFraction
+	}
+
+	out := &weather.Forecast{}
+	for _, date := range order {
+		out.Days = append(out.Days, *byDate[date])
+	}
+	return out, nil
+}
+
+// metNoResponse is the subset of MET Norway's Locationforecast/2.0 response
+// we consume.
+type metNoResponse struct {
+	Properties struct {
+		Timeseries []struct {
+			Time string `json:"time"`
+			Data struct {
+				Instant struct {
+					Details struct {
+						AirTemperature    float64 `json:"air_temperature"`
+						RelativeHumidity  float64 `json:"relative_humidity"`
+						WindSpeed         float64 `json:"wind_speed"`
+						CloudAreaFraction float64 `json:"cloud_area_fraction"`
+					} `json:"details"`
+				} `json:"instant"`
+			} `json:"data"`
+		} `json:"timeseries"`
+	} `json:"properties"`
+}
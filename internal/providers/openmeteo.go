@@ -0,0 +1,126 @@
+package providers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hectormalot/omgo"
+
+	"github.com/Eli-Goldberg/random-city-weather/internal/weather"
+)
+
+// currentHourlyMetrics are the hourly fields requested for Current. They
+// exist only on the hourly/forecast endpoint: omgo.Client.CurrentWeather
+// discards any HourlyMetrics/DailyMetrics before making the request, and its
+// response has no humidity or cloud-cover fields at all.
+var currentHourlyMetrics = []string{"relativehumidity_2m", "cloudcover"}
+
+// OpenMeteo is a WeatherProvider backed by the Open-Meteo API via the omgo
+// client. It was the program's original (and only) data source.
+type OpenMeteo struct {
+	client omgo.Client
+}
+
+// NewOpenMeteo wraps an existing omgo client as a WeatherProvider.
+func NewOpenMeteo(client omgo.Client) *OpenMeteo {
+	return &OpenMeteo{client: client}
+}
+
+func (p *OpenMeteo) Name() string { return "open-meteo" }
+
+func (p *OpenMeteo) Current(ctx context.Context, coord weather.Coordinates) (*weather.Weather, error) {
+	loc, err := omgo.NewLocation(coord.Latitude, coord.Longitude)
+	if err != nil {
+		return nil, err
+	}
+
+	opts := omgo.Options{
+		TemperatureUnit: "celsius",
+		Timezone:        "Asia/Jerusalem",
+		HourlyMetrics:   currentHourlyMetrics,
+	}
+
+	fc, err := p.client.Forecast(ctx, loc, &opts)
+	if err != nil {
+		return nil, fmt.Errorf("open-meteo: %w", err)
+	}
+
+	w := &weather.Weather{
+		Temperature: fc.CurrentWeather.Temperature,
+		WindSpeed:   fc.CurrentWeather.WindSpeed,
+	}
+	if i, ok := nearestHourIndex(fc.HourlyTimes); ok {
+		w.Humidity = fc.HourlyMetrics["relativehumidity_2m"][i]
+		w.CloudCover = fc.HourlyMetrics["cloudcover"][i]
+	}
+	return w, nil
+}
+
+// nearestHourIndex returns the index into times (assumed hourly and in
+// order) closest to now, or false if times is empty.
+func nearestHourIndex(times []time.Time) (int, bool) {
+	if len(times) == 0 {
+		return 0, false
+	}
+	now := time.Now()
+	best := 0
+	bestDiff := times[0].Sub(now).Abs()
+	for i := 1; i < len(times); i++ {
+		if diff := times[i].Sub(now).Abs(); diff < bestDiff {
+			best, bestDiff = i, diff
+		}
+	}
+	return best, true
+}
+
+// dailyMaxTempMetric is the daily metric name omgo reports the day's peak
+// temperature under; see https://open-meteo.com/en/docs for the full list.
+const dailyMaxTempMetric = "temperature_2m_max"
+
+func (p *OpenMeteo) Forecast(ctx context.Context, coord weather.Coordinates, fopts ForecastOptions) (*weather.Forecast, error) {
+	loc, err := omgo.NewLocation(coord.Latitude, coord.Longitude)
+	if err != nil {
+		return nil, err
+	}
+
+	dailyMetrics := fopts.DailyMetrics
+	if !contains(dailyMetrics, dailyMaxTempMetric) {
+		dailyMetrics = append(append([]string{}, dailyMetrics...), dailyMaxTempMetric)
+	}
+
+	opts := omgo.Options{
+		TemperatureUnit: "celsius",
+		Timezone:        "Asia/Jerusalem",
+		HourlyMetrics:   fopts.HourlyMetrics,
+		DailyMetrics:    dailyMetrics,
+	}
+
+	fc, err := p.client.Forecast(ctx, loc, &opts)
+	if err != nil {
+		return nil, fmt.Errorf("open-meteo: %w", err)
+	}
+
+	tempMax := fc.DailyMetrics[dailyMaxTempMetric]
+	out := &weather.Forecast{}
+	for i, date := range fc.DailyTimes {
+		if fopts.Days > 0 && i >= fopts.Days {
+			break
+		}
+		day := weather.DayForecast{Date: date.Format("2006-01-02")}
+		if i < len(tempMax) {
+			day.TempMax = tempMax[i]
+		}
+		out.Days = append(out.Days, day)
+	}
+	return out, nil
+}
+
+func contains(ss []string, s string) bool {
+	for _, v := range ss {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
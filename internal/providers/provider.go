@@ -0,0 +1,27 @@
+// Package providers defines a backend-agnostic weather data source and ships
+// implementations backed by different upstream APIs, so the rest of the
+// program can switch providers (or fall back between them) without caring
+// which one actually answered.
+package providers
+
+import (
+	"context"
+
+	"github.com/Eli-Goldberg/random-city-weather/internal/weather"
+)
+
+// ForecastOptions selects which metrics a Forecast call should return.
+type ForecastOptions struct {
+	Days          int
+	HourlyMetrics []string
+	DailyMetrics  []string
+}
+
+// WeatherProvider is implemented by anything that can answer current-
+// conditions and forecast queries for a set of coordinates.
+type WeatherProvider interface {
+	// Name identifies the provider for logging and metrics labels.
+	Name() string
+	Current(ctx context.Context, coord weather.Coordinates) (*weather.Weather, error)
+	Forecast(ctx context.Context, coord weather.Coordinates, opts ForecastOptions) (*weather.Forecast, error)
+}
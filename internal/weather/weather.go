@@ -0,0 +1,87 @@
+// Package weather holds the reusable city-lookup and weather-fetching logic
+// that used to live directly in main. It has no opinion on how it is served
+// (CLI loop, gRPC, HTTP, ...) — callers drive it.
+package weather
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"net/http"
+)
+
+// Coordinates is a resolved latitude/longitude pair.
+type Coordinates struct {
+	Latitude  float64 `json:"lat,string"`
+	Longitude float64 `json:"lon,string"`
+}
+
+// Country mirrors the subset of the restcountries.com response we care about.
+type Country struct {
+	Name struct {
+		Common string `json:"common"`
+	} `json:"name"`
+	Capital []string `json:"capital"`
+	// Cca2 is the country's ISO 3166-1 alpha-2 code, used to disambiguate
+	// capitals with common names (there's more than one "San Jose") when
+	// geocoding.
+	Cca2 string `json:"cca2"`
+}
+
+// Weather is the current-conditions reading we surface to callers, trimmed
+// down to the fields the rest of the program consumes.
+type Weather struct {
+	Temperature float64
+	Humidity    float64
+	CloudCover  float64
+	WindSpeed   float64
+}
+
+// Forecast is a multi-day outlook for a single location.
+type Forecast struct {
+	Days []DayForecast
+}
+
+// DayForecast is one day's worth of forecasted metrics.
+type DayForecast struct {
+	Date       string
+	TempMax    float64
+	TempMin    float64
+	Humidity   float64
+	CloudCover float64
+}
+
+// LoadRandomCountriesAndCapitals fetches the full restcountries.com dataset.
+func LoadRandomCountriesAndCapitals(ctx context.Context) ([]Country, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "https://restcountries.com/v3.1/all", nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build request: %v", err)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch data: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("API request failed with status code: %v", resp.StatusCode)
+	}
+
+	var countries []Country
+	if err := json.NewDecoder(resp.Body).Decode(&countries); err != nil {
+		return nil, fmt.Errorf("failed to decode JSON: %v", err)
+	}
+	return countries, nil
+}
+
+// GetRandomCity picks one of countries' capitals at random.
+func GetRandomCity(countries []Country) string {
+	randomIndex := rand.Intn(len(countries))
+	capitals := countries[randomIndex].Capital
+	if len(capitals) == 0 {
+		return "Unknown"
+	}
+	return capitals[0]
+}
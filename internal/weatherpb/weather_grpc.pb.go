@@ -0,0 +1,194 @@
+// Hand-written gRPC bindings for internal/weatherpb/weather.proto.
+//
+// This is NOT protoc-gen-go-grpc output (that plugin isn't available in
+// this build environment) — it's written to match the client/server
+// interfaces protoc-gen-go-grpc would generate, but it predates that
+// generator's Unsafe*Server/mustEmbedUnimplemented*/SupportPackageIsVersion
+// conventions. Edit it directly; if protoc-gen-go-grpc becomes available,
+// prefer regenerating from weather.proto instead.
+
+package weatherpb
+
+import (
+	context "context"
+
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// WeatherServiceClient is the client API for WeatherService.
+type WeatherServiceClient interface {
+	Current(ctx context.Context, in *Location, opts ...grpc.CallOption) (*Weather, error)
+	Forecast(ctx context.Context, in *ForecastRequest, opts ...grpc.CallOption) (*Forecast, error)
+	RandomCapitalStream(ctx context.Context, in *Interval, opts ...grpc.CallOption) (WeatherService_RandomCapitalStreamClient, error)
+}
+
+type weatherServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+// NewWeatherServiceClient wraps an existing connection in a typed client.
+func NewWeatherServiceClient(cc grpc.ClientConnInterface) WeatherServiceClient {
+	return &weatherServiceClient{cc}
+}
+
+func (c *weatherServiceClient) Current(ctx context.Context, in *Location, opts ...grpc.CallOption) (*Weather, error) {
+	out := new(Weather)
+	if err := c.cc.Invoke(ctx, "/weatherpb.WeatherService/Current", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *weatherServiceClient) Forecast(ctx context.Context, in *ForecastRequest, opts ...grpc.CallOption) (*Forecast, error) {
+	out := new(Forecast)
+	if err := c.cc.Invoke(ctx, "/weatherpb.WeatherService/Forecast", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *weatherServiceClient) RandomCapitalStream(ctx context.Context, in *Interval, opts ...grpc.CallOption) (WeatherService_RandomCapitalStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_WeatherService_serviceDesc.Streams[0], "/weatherpb.WeatherService/RandomCapitalStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &weatherServiceRandomCapitalStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// WeatherService_RandomCapitalStreamClient is the client-side stream handle
+// returned by RandomCapitalStream.
+type WeatherService_RandomCapitalStreamClient interface {
+	Recv() (*RandomCapitalWeather, error)
+	grpc.ClientStream
+}
+
+type weatherServiceRandomCapitalStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *weatherServiceRandomCapitalStreamClient) Recv() (*RandomCapitalWeather, error) {
+	m := new(RandomCapitalWeather)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// WeatherServiceServer is the server API for WeatherService.
+type WeatherServiceServer interface {
+	Current(context.Context, *Location) (*Weather, error)
+	Forecast(context.Context, *ForecastRequest) (*Forecast, error)
+	RandomCapitalStream(*Interval, WeatherService_RandomCapitalStreamServer) error
+}
+
+// UnimplementedWeatherServiceServer can be embedded to have forward
+// compatible implementations.
+type UnimplementedWeatherServiceServer struct{}
+
+func (UnimplementedWeatherServiceServer) Current(context.Context, *Location) (*Weather, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Current not implemented")
+}
+
+func (UnimplementedWeatherServiceServer) Forecast(context.Context, *ForecastRequest) (*Forecast, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Forecast not implemented")
+}
+
+func (UnimplementedWeatherServiceServer) RandomCapitalStream(*Interval, WeatherService_RandomCapitalStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method RandomCapitalStream not implemented")
+}
+
+// RegisterWeatherServiceServer registers srv with s.
+func RegisterWeatherServiceServer(s grpc.ServiceRegistrar, srv WeatherServiceServer) {
+	s.RegisterService(&_WeatherService_serviceDesc, srv)
+}
+
+func _WeatherService_Current_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Location)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeatherServiceServer).Current(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/weatherpb.WeatherService/Current",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeatherServiceServer).Current(ctx, req.(*Location))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WeatherService_Forecast_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ForecastRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeatherServiceServer).Forecast(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/weatherpb.WeatherService/Forecast",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeatherServiceServer).Forecast(ctx, req.(*ForecastRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WeatherService_RandomCapitalStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(Interval)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(WeatherServiceServer).RandomCapitalStream(m, &weatherServiceRandomCapitalStreamServer{stream})
+}
+
+// WeatherService_RandomCapitalStreamServer is the server-side stream handle
+// returned to RandomCapitalStream implementations.
+type WeatherService_RandomCapitalStreamServer interface {
+	Send(*RandomCapitalWeather) error
+	grpc.ServerStream
+}
+
+type weatherServiceRandomCapitalStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *weatherServiceRandomCapitalStreamServer) Send(m *RandomCapitalWeather) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _WeatherService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "weatherpb.WeatherService",
+	HandlerType: (*WeatherServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Current",
+			Handler:    _WeatherService_Current_Handler,
+		},
+		{
+			MethodName: "Forecast",
+			Handler:    _WeatherService_Forecast_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "RandomCapitalStream",
+			Handler:       _WeatherService_RandomCapitalStream_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "internal/weatherpb/weather.proto",
+}
@@ -0,0 +1,166 @@
+// Package weatherserver implements the weatherpb.WeatherServiceServer on top
+// of the internal/weather package.
+package weatherserver
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Eli-Goldberg/random-city-weather/internal/geocode"
+	"github.com/Eli-Goldberg/random-city-weather/internal/providers"
+	"github.com/Eli-Goldberg/random-city-weather/internal/weather"
+	"github.com/Eli-Goldberg/random-city-weather/internal/weatherpb"
+)
+
+// Server implements weatherpb.WeatherServiceServer.
+type Server struct {
+	weatherpb.UnimplementedWeatherServiceServer
+
+	geocoder  geocode.Geocoder
+	provider  providers.WeatherProvider
+	countries []weather.Country
+}
+
+// New builds a Server. countries is the capital-city dataset used by
+// RandomCapitalStream.
+func New(geocoder geocode.Geocoder, provider providers.WeatherProvider, countries []weather.Country) *Server {
+	return &Server{geocoder: geocoder, provider: provider, countries: countries}
+}
+
+// resolveLocation turns a weatherpb.Location into weather.Coordinates,
+// resolving city names via the configured Geocoder when needed.
+func (s *Server) resolveLocation(ctx context.Context, loc *weatherpb.Location) (weather.Coordinates, error) {
+	if loc == nil {
+		return weather.Coordinates{}, status.Error(codes.InvalidArgument, "location is required")
+	}
+
+	switch v := loc.GetValue().(type) {
+	case *weatherpb.Location_LatLon:
+		return weather.Coordinates{Latitude: v.LatLon.GetLatitude(), Longitude: v.LatLon.GetLongitude()}, nil
+	case *weatherpb.Location_CityName:
+		coords, err := s.geocoder.ByCity(ctx, v.CityName, "")
+		if err != nil {
+			return weather.Coordinates{}, status.Errorf(codes.NotFound, "resolving %q: %v", v.CityName, err)
+		}
+		return coords, nil
+	case *weatherpb.Location_PostalCode:
+		coords, err := s.geocoder.ByPostalCode(ctx, v.PostalCode.GetZip(), v.PostalCode.GetCountryCode())
+		if err != nil {
+			return weather.Coordinates{}, status.Errorf(codes.NotFound, "resolving %q: %v", v.PostalCode.GetZip(), err)
+		}
+		return coords, nil
+	default:
+		return weather.Coordinates{}, status.Error(codes.InvalidArgument, "location must set city_name, lat_lon, or postal_code")
+	}
+}
+
+// Current implements weatherpb.WeatherServiceServer.
+func (s *Server) Current(ctx context.Context, loc *weatherpb.Location) (*weatherpb.Weather, error) {
+	coords, err := s.resolveLocation(ctx, loc)
+	if err != nil {
+		return nil, err
+	}
+
+	w, err := s.provider.Current(ctx, coords)
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "fetching weather: %v", err)
+	}
+
+	return &weatherpb.Weather{
+		TemperatureC:      w.Temperature,
+		HumidityPercent:   w.Humidity,
+		CloudCoverPercent: w.CloudCover,
+		WindSpeed:         w.WindSpeed,
+	}, nil
+}
+
+// Forecast implements weatherpb.WeatherServiceServer.
+func (s *Server) Forecast(ctx context.Context, req *weatherpb.ForecastRequest) (*weatherpb.Forecast, error) {
+	coords, err := s.resolveLocation(ctx, req.GetLocation())
+	if err != nil {
+		return nil, err
+	}
+
+	days := int(req.GetDays())
+	if days <= 0 {
+		days = 1
+	}
+
+	fc, err := s.provider.Forecast(ctx, coords, providers.ForecastOptions{
+		Days:          days,
+		HourlyMetrics: req.GetHourlyMetrics(),
+		DailyMetrics:  req.GetDailyMetrics(),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "fetching forecast: %v", err)
+	}
+
+	out := &weatherpb.Forecast{}
+	for _, d := range fc.Days {
+		out.Days = append(out.Days, &weatherpb.DayForecast{
+			Date:              d.Date,
+			TempMaxC:          d.TempMax,
+			TempMinC:          d.TempMin,
+			HumidityPercent:   d.Humidity,
+			CloudCoverPercent: d.CloudCover,
+		})
+	}
+	return out, nil
+}
+
+// RandomCapitalStream implements weatherpb.WeatherServiceServer, reproducing
+// the original CLI's polling loop as a server-streaming RPC.
+func (s *Server) RandomCapitalStream(interval *weatherpb.Interval, stream weatherpb.WeatherService_RandomCapitalStreamServer) error {
+	if len(s.countries) == 0 {
+		return status.Error(codes.FailedPrecondition, "no countries loaded")
+	}
+
+	seconds := interval.GetSeconds()
+	if seconds <= 0 {
+		seconds = 5
+	}
+
+	ctx := stream.Context()
+	ticker := time.NewTicker(time.Duration(seconds) * time.Second)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+			idx := 0
+			if len(s.countries) > 1 {
+				idx = int(time.Now().UnixNano()) % len(s.countries)
+			}
+			country := s.countries[idx]
+			city := weather.GetRandomCity([]weather.Country{country})
+
+			coords, err := s.geocoder.ByCity(ctx, city, country.Cca2)
+			if err != nil {
+				continue
+			}
+			w, err := s.provider.Current(ctx, coords)
+			if err != nil {
+				continue
+			}
+
+			msg := &weatherpb.RandomCapitalWeather{
+				Country: country.Name.Common,
+				City:    city,
+				Weather: &weatherpb.Weather{
+					TemperatureC:      w.Temperature,
+					HumidityPercent:   w.Humidity,
+					CloudCoverPercent: w.CloudCover,
+					WindSpeed:         w.WindSpeed,
+				},
+			}
+			if err := stream.Send(msg); err != nil {
+				return err
+			}
+		}
+	}
+}
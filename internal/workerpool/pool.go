@@ -0,0 +1,171 @@
+// Package workerpool runs the random-capital fetch loop as a bounded,
+// concurrent pool instead of a single ticker, so one slow upstream call
+// doesn't stall the next fetch.
+package workerpool
+
+import (
+	"context"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/Eli-Goldberg/random-city-weather/internal/geocode"
+	"github.com/Eli-Goldberg/random-city-weather/internal/metrics"
+	"github.com/Eli-Goldberg/random-city-weather/internal/providers"
+	"github.com/Eli-Goldberg/random-city-weather/internal/weather"
+)
+
+// Config controls the pool's concurrency and scheduling.
+type Config struct {
+	// Workers is the number of goroutines draining the job queue.
+	Workers int
+	// Interval is the base delay between scheduled fetches.
+	Interval time.Duration
+	// Jitter adds up to this much random extra delay to each interval, to
+	// avoid every instance of the program hammering upstreams in lockstep.
+	Jitter time.Duration
+	// MaxInFlight bounds the number of fetches running concurrently, which
+	// may be lower than Workers.
+	MaxInFlight int
+	// ShutdownGrace bounds how long a fetch already in flight when Run's ctx
+	// is canceled gets to finish, instead of being aborted mid-request.
+	ShutdownGrace time.Duration
+}
+
+// Result is what a single fetch produced, successful or not.
+type Result struct {
+	Country     weather.Country
+	City        string
+	Coordinates weather.Coordinates
+	Weather     *weather.Weather
+	Provider    string
+	Err         error
+}
+
+// ResultHandler is called once per completed fetch, from a worker goroutine.
+type ResultHandler func(Result)
+
+// Pool fetches weather for random capitals on a schedule, bounding
+// concurrency and reporting Prometheus metrics per request.
+type Pool struct {
+	cfg       Config
+	geocoder  geocode.Geocoder
+	provider  providers.WeatherProvider
+	countries []weather.Country
+	metrics   *metrics.Metrics
+	handler   ResultHandler
+
+	sem chan struct{}
+}
+
+// New builds a Pool. countries must be non-empty.
+func New(cfg Config, geocoder geocode.Geocoder, provider providers.WeatherProvider, countries []weather.Country, m *metrics.Metrics, handler ResultHandler) *Pool {
+	if cfg.Workers <= 0 {
+		cfg.Workers = 1
+	}
+	if cfg.MaxInFlight <= 0 {
+		cfg.MaxInFlight = cfg.Workers
+	}
+	if cfg.ShutdownGrace <= 0 {
+		cfg.ShutdownGrace = 10 * time.Second
+	}
+	return &Pool{
+		cfg:       cfg,
+		geocoder:  geocoder,
+		provider:  provider,
+		countries: countries,
+		metrics:   m,
+		handler:   handler,
+		sem:       make(chan struct{}, cfg.MaxInFlight),
+	}
+}
+
+// Run schedules fetches onto cfg.Workers worker goroutines until ctx is
+// canceled, then waits for in-flight work to finish before returning.
+func (p *Pool) Run(ctx context.Context) {
+	jobs := make(chan struct{})
+
+	var workers sync.WaitGroup
+	for i := 0; i < p.cfg.Workers; i++ {
+		workers.Add(1)
+		go func() {
+			defer workers.Done()
+			for range jobs {
+				p.fetchOne(ctx)
+			}
+		}()
+	}
+
+	p.schedule(ctx, jobs)
+
+	// schedule returns once ctx is done; jobs is closed so workers drain
+	// whatever is already queued and then exit.
+	workers.Wait()
+}
+
+func (p *Pool) schedule(ctx context.Context, jobs chan<- struct{}) {
+	defer close(jobs)
+
+	for {
+		delay := p.cfg.Interval
+		if p.cfg.Jitter > 0 {
+			delay += time.Duration(rand.Int63n(int64(p.cfg.Jitter)))
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+			select {
+			case jobs <- struct{}{}:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}
+}
+
+// fetchOne resolves and fetches weather for one random capital, bounded by
+// MaxInFlight and tracked by Prometheus metrics. Once a fetch is admitted it
+// runs to completion on a context detached from ctx's cancellation, so a
+// SIGINT during shutdown lets in-flight upstream calls finish (bounded by
+// ShutdownGrace) instead of aborting them mid-request.
+func (p *Pool) fetchOne(ctx context.Context) {
+	select {
+	case p.sem <- struct{}{}:
+	case <-ctx.Done():
+		return
+	}
+	defer func() { <-p.sem }()
+
+	fetchCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), p.cfg.ShutdownGrace)
+	defer cancel()
+
+	country := p.countries[rand.Intn(len(p.countries))]
+	city := weather.GetRandomCity([]weather.Country{country})
+
+	geocodeStart := time.Now()
+	coords, err := p.geocoder.ByCity(fetchCtx, city, country.Cca2)
+	p.metrics.GeocodeLatency.WithLabelValues(p.geocoder.Name()).Observe(time.Since(geocodeStart).Seconds())
+	if err != nil {
+		p.metrics.RequestsTotal.WithLabelValues("geocode", "error").Inc()
+		p.handler(Result{Country: country, City: city, Err: err})
+		return
+	}
+	p.metrics.RequestsTotal.WithLabelValues("geocode", "success").Inc()
+
+	weatherStart := time.Now()
+	w, err := p.provider.Current(fetchCtx, coords)
+	p.metrics.WeatherLatency.WithLabelValues(p.provider.Name()).Observe(time.Since(weatherStart).Seconds())
+	if err != nil {
+		p.metrics.RequestsTotal.WithLabelValues(p.provider.Name(), "error").Inc()
+		p.handler(Result{Country: country, City: city, Err: err})
+		return
+	}
+	p.metrics.RequestsTotal.WithLabelValues(p.provider.Name(), "success").Inc()
+	p.metrics.TemperatureByCity.WithLabelValues(city, country.Name.Common).Set(w.Temperature)
+
+	p.handler(Result{Country: country, City: city, Coordinates: coords, Weather: w, Provider: p.provider.Name()})
+}
@@ -2,153 +2,203 @@ package main
 
 import (
 	"context"
-	"encoding/json"
+	"flag"
 	"fmt"
-	"io"
-	"math/rand"
+	"net"
 	"net/http"
-	"net/url"
 	"os"
 	"os/signal"
+	"strconv"
+	"sync"
 	"time"
 
 	"github.com/hectormalot/omgo"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc"
+
+	"github.com/Eli-Goldberg/random-city-weather/internal/geocode"
+	"github.com/Eli-Goldberg/random-city-weather/internal/metrics"
+	"github.com/Eli-Goldberg/random-city-weather/internal/output"
+	"github.com/Eli-Goldberg/random-city-weather/internal/providers"
+	"github.com/Eli-Goldberg/random-city-weather/internal/weather"
+	"github.com/Eli-Goldberg/random-city-weather/internal/weatherpb"
+	"github.com/Eli-Goldberg/random-city-weather/internal/weatherserver"
+	"github.com/Eli-Goldberg/random-city-weather/internal/workerpool"
 )
 
-type Coordinates struct {
-	Latitude  float64 `json:"lat,string"`
-	Longitude float64 `json:"lon,string"`
-}
-
-type Country struct {
-	Name struct {
-		Common string `json:"common"`
-	} `json:"name"`
-	Capital []string `json:"capital"`
-}
-
 func main() {
-	c, _ := omgo.NewClient()
+	addr := flag.String("addr", ":50051", "address for the gRPC server to listen on")
+	metricsAddr := flag.String("metrics-addr", ":9090", "address for the /metrics HTTP endpoint")
+	providerName := flag.String("provider", envOr("WEATHER_PROVIDER", providers.OpenMeteoName), "weather provider to use: open-meteo, met-no, or chain")
+	geocoderName := flag.String("geocoder", envOr("GEOCODE_PROVIDER", geocode.NominatimName), "geocoder to use: nominatim or open-meteo")
+	workers := flag.Int("workers", envIntOr("WORKERS", 4), "number of concurrent fetch workers")
+	interval := flag.Duration("interval", envDurationOr("INTERVAL", 5*time.Second), "base delay between scheduled fetches")
+	jitter := flag.Duration("jitter", envDurationOr("JITTER", time.Second), "random extra delay added to each interval")
+	maxInFlight := flag.Int("max-in-flight", envIntOr("MAX_IN_FLIGHT", 4), "maximum number of fetches running concurrently")
+	outputMode := flag.String("output", output.HumanName, "output mode: human, ndjson, csv, or sqlite")
+	outputPath := flag.String("output-path", "", "file path for ndjson/csv/sqlite output (empty means stdout for human/ndjson)")
+	flag.Parse()
+
 	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
 	defer stop()
 
-	fmt.Println("Loading random capitals...")
-	capitals, err := loadRandomCountriesAndCapitals()
+	omgoClient, err := omgo.NewClient()
 	if err != nil {
-		fmt.Printf("Error loading cities: %v", err)
+		fmt.Printf("Error creating omgo client: %v\n", err)
 		os.Exit(1)
 	}
-	fetchWeather(ctx, c, capitals)
-}
 
-func loadRandomCountriesAndCapitals() ([]Country, error) {
-	// Make an HTTP GET request to the API
-	resp, err := http.Get("https://restcountries.com/v3.1/all")
+	provider, err := providers.New(*providerName, omgoClient)
 	if err != nil {
-		return nil, fmt.Errorf("failed to fetch data: %v", err)
-	}
-	defer resp.Body.Close()
-
-	// Check if the response status code is OK (200)
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("API request failed with status code: %v", resp.StatusCode)
+		fmt.Printf("Error selecting weather provider: %v\n", err)
+		os.Exit(1)
 	}
 
-	// Decode the JSON response into a slice of Country objects
-	var countries []Country
-	err = json.NewDecoder(resp.Body).Decode(&countries)
+	geocoder, err := geocode.New(*geocoderName)
 	if err != nil {
-		return nil, fmt.Errorf("failed to decode JSON: %v", err)
-	}
-	return countries, nil
-}
-
-func fetchWeather(ctx context.Context, client omgo.Client, capitals []Country) {
-	ticker := time.NewTicker(5 * time.Second)
-	for {
-		select {
-		case <-ticker.C:
-			city := getRandomCity(capitals)
-			coordinates, err := GetCoordinates(ctx, city)
-			if err != nil {
-				fmt.Println(err.Error())
-				continue
-			}
-			weather, err := getWeather(ctx, client, coordinates)
-			if err == nil {
-				fmt.Printf("the Temperature in %s is: %.1f°C\n", city, weather.Temperature)
-			}
-		case <-ctx.Done():
-			return
-		}
+		fmt.Printf("Error selecting geocoder: %v\n", err)
+		os.Exit(1)
 	}
-}
 
-// A Go function that receives a city name and gets it's coordinates (lat and long)
-func GetCoordinates(ctx context.Context, city string) (Coordinates, error) {
-	baseURL := "https://nominatim.openstreetmap.org/search"
-	// Construct the query parameters
-	queryParams := url.Values{}
-	queryParams.Set("q", city)
-	queryParams.Set("format", "json")
-	endpointURL := fmt.Sprintf("%s?%s", baseURL, queryParams.Encode())
-
-	// Send a GET request to the API endpoint
-	response, err := http.Get(endpointURL)
+	fmt.Println("Loading random capitals...")
+	countries, err := weather.LoadRandomCountriesAndCapitals(ctx)
 	if err != nil {
-		return Coordinates{}, err
+		fmt.Printf("Error loading cities: %v\n", err)
+		os.Exit(1)
 	}
-	defer response.Body.Close()
 
-	// Read the response body
-	body, err := io.ReadAll(response.Body)
+	sink, err := output.New(*outputMode, *outputPath)
 	if err != nil {
-		return Coordinates{}, err
+		fmt.Printf("Error selecting output sink: %v\n", err)
+		os.Exit(1)
 	}
+	defer sink.Close()
 
-	// Unmarshal the response JSON into a slice of coordinates
-	var coordinates []Coordinates
-	err = json.Unmarshal(body, &coordinates)
+	lis, err := net.Listen("tcp", *addr)
 	if err != nil {
-		return Coordinates{}, err
+		fmt.Printf("Error listening on %s: %v\n", *addr, err)
+		os.Exit(1)
 	}
 
-	if len(coordinates) == 0 {
-		return Coordinates{}, fmt.Errorf("no coordinates found for %s", city)
+	grpcServer := grpc.NewServer()
+	weatherpb.RegisterWeatherServiceServer(grpcServer, weatherserver.New(geocoder, provider, countries))
+
+	m := metrics.New(prometheus.DefaultRegisterer)
+	metricsServer := &http.Server{Addr: *metricsAddr, Handler: metrics.Handler()}
+
+	handler := newResultLogger(sink)
+
+	pool := workerpool.New(workerpool.Config{
+		Workers:     *workers,
+		Interval:    *interval,
+		Jitter:      *jitter,
+		MaxInFlight: *maxInFlight,
+	}, geocoder, provider, countries, m, handler.handle)
+
+	var wg sync.WaitGroup
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		pool.Run(ctx)
+	}()
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		_ = metricsServer.Shutdown(shutdownCtx)
+	}()
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		fmt.Printf("metrics listening on %s\n", *metricsAddr)
+		if err := metricsServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			fmt.Printf("Error serving metrics: %v\n", err)
+		}
+	}()
+
+	go func() {
+		<-ctx.Done()
+		grpcServer.GracefulStop()
+	}()
+
+	fmt.Printf("weather gRPC server listening on %s\n", *addr)
+	if err := grpcServer.Serve(lis); err != nil {
+		fmt.Printf("Error serving gRPC: %v\n", err)
 	}
 
-	// Return the first set of coordinates
-	return coordinates[0], nil
+	wg.Wait()
 }
 
-// Get the current weather for amsterdam
-func getWeather(ctx context.Context, c omgo.Client, coord Coordinates) (*omgo.CurrentWeather, error) {
+// resultLogger adapts workerpool.ResultHandler (which carries no context) to
+// output.Sink.Write (which does).
+type resultLogger struct {
+	sink output.Sink
+}
 
-	// Get the humidity and cloud cover forecast for berlin,
-	// including the last 2 days and non-metric units
-	loc, _ := omgo.NewLocation(coord.Latitude, coord.Longitude)
+func newResultLogger(sink output.Sink) *resultLogger {
+	return &resultLogger{sink: sink}
+}
 
-	opts := omgo.Options{
-		TemperatureUnit: "celsius",
-		Timezone:        "Asia/Jerusalem",
-		PastDays:        2,
-		HourlyMetrics:   []string{"cloudcover, relativehumidity_2m"},
-		DailyMetrics:    []string{"temperature_2m_max"},
+func (l *resultLogger) handle(r workerpool.Result) {
+	if r.Err != nil {
+		fmt.Println(r.Err.Error())
+		return
 	}
 
-	weather, err := c.CurrentWeather(ctx, loc, &opts)
-	if err != nil {
-		return nil, err
+	obs := output.Observation{
+		Timestamp:         time.Now(),
+		Country:           r.Country.Name.Common,
+		City:              r.City,
+		Latitude:          r.Coordinates.Latitude,
+		Longitude:         r.Coordinates.Longitude,
+		TemperatureC:      r.Weather.Temperature,
+		HumidityPercent:   r.Weather.Humidity,
+		CloudCoverPercent: r.Weather.CloudCover,
+		WindSpeed:         r.Weather.WindSpeed,
+		Provider:          r.Provider,
+	}
+	if err := l.sink.Write(context.Background(), obs); err != nil {
+		fmt.Printf("Error writing observation: %v\n", err)
 	}
+}
 
-	return &weather, nil
+// envOr returns os.Getenv(key), falling back to def when unset.
+func envOr(key, def string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+	return def
 }
 
-func getRandomCity(countries []Country) string {
-	randomIndex := rand.Intn(len(countries))
-	capitals := countries[randomIndex].Capital
-	if len(capitals) == 0 {
-		return "Unknown"
+// envIntOr returns os.Getenv(key) parsed as an int, falling back to def when
+// unset or unparseable.
+func envIntOr(key string, def int) int {
+	v := os.Getenv(key)
+	if v == "" {
+		return def
+	}
+	n, err := strconv.Atoi(v)
+	if err != nil {
+		return def
+	}
+	return n
+}
+
+// envDurationOr returns os.Getenv(key) parsed as a time.Duration, falling
+// back to def when unset or unparseable.
+func envDurationOr(key string, def time.Duration) time.Duration {
+	v := os.Getenv(key)
+	if v == "" {
+		return def
+	}
+	d, err := time.ParseDuration(v)
+	if err != nil {
+		return def
 	}
-	return capitals[0]
+	return d
 }